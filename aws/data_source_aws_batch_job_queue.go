@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsBatchJobQueue() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBatchJobQueueRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"scheduling_policy_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_environment_order": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_environment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"order": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	name := d.Get("name").(string)
+	jq, err := getJobQueue(conn, name)
+	if err != nil {
+		return err
+	}
+	if jq == nil {
+		return fmt.Errorf("Batch Job Queue (%s) not found", name)
+	}
+
+	d.SetId(aws.StringValue(jq.JobQueueArn))
+	d.Set("arn", jq.JobQueueArn)
+	d.Set("state", jq.State)
+	d.Set("status", jq.Status)
+	d.Set("priority", jq.Priority)
+	d.Set("scheduling_policy_arn", jq.SchedulingPolicyArn)
+
+	sort.Slice(jq.ComputeEnvironmentOrder, func(i, j int) bool {
+		return aws.Int64Value(jq.ComputeEnvironmentOrder[i].Order) < aws.Int64Value(jq.ComputeEnvironmentOrder[j].Order)
+	})
+
+	computeEnvironmentOrder := make([]interface{}, 0, len(jq.ComputeEnvironmentOrder))
+	for _, ceo := range jq.ComputeEnvironmentOrder {
+		computeEnvironmentOrder = append(computeEnvironmentOrder, map[string]interface{}{
+			"compute_environment": aws.StringValue(ceo.ComputeEnvironment),
+			"order":               int(aws.Int64Value(ceo.Order)),
+		})
+	}
+
+	if err := d.Set("compute_environment_order", computeEnvironmentOrder); err != nil {
+		return fmt.Errorf("error setting compute_environment_order: %w", err)
+	}
+
+	return nil
+}
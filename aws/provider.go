@@ -0,0 +1,21 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns a *schema.Provider covering the AWS Batch resources and
+// data sources implemented in this tree.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_batch_job":               resourceAwsBatchJob(),
+			"aws_batch_job_queue":         resourceAwsBatchJobQueue(),
+			"aws_batch_scheduling_policy": resourceAwsBatchSchedulingPolicy(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_batch_job_queue": dataSourceAwsBatchJobQueue(),
+		},
+	}
+}
@@ -0,0 +1,533 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const (
+	batchJobCreateTimeout = 10 * time.Minute
+	batchJobDeleteTimeout = 10 * time.Minute
+)
+
+func resourceAwsBatchJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBatchJobCreate,
+		Read:   resourceAwsBatchJobRead,
+		Delete: resourceAwsBatchJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(batchJobCreateTimeout),
+			Delete: schema.DefaultTimeout(batchJobDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"job_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchName,
+			},
+			"job_queue": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"container_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"resource_requirements": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(batch.ResourceType_Values(), false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"array_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"job_dependency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(batch.ArrayJobDependency_Values(), false),
+						},
+					},
+				},
+			},
+			"retry_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+			"timeout": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempt_duration_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+			"propagate_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stopped_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_stream_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exit_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": tagsSchemaComputed(),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+}
+
+func resourceAwsBatchJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("job_name").(string)
+	input := &batch.SubmitJobInput{
+		JobName:            aws.String(name),
+		JobQueue:           aws.String(d.Get("job_queue").(string)),
+		JobDefinition:      aws.String(d.Get("job_definition").(string)),
+		Parameters:         expandBatchJobParameters(d.Get("parameters").(map[string]interface{})),
+		ContainerOverrides: expandBatchJobContainerOverrides(d.Get("container_overrides").([]interface{})),
+		ArrayProperties:    expandBatchJobArrayProperties(d.Get("array_properties").([]interface{})),
+		DependsOn:          expandBatchJobDependsOn(d.Get("job_dependency").([]interface{})),
+		RetryStrategy:      expandBatchJobRetryStrategy(d.Get("retry_strategy").([]interface{})),
+		Timeout:            expandBatchJobTimeout(d.Get("timeout").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("propagate_tags"); ok {
+		input.PropagateTags = aws.Bool(v.(bool))
+	}
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().BatchTags()
+	}
+
+	out, err := conn.SubmitJob(input)
+	if err != nil {
+		return fmt.Errorf("error submitting Batch Job (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(out.JobId))
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{batch.JobStatusSubmitted, batch.JobStatusPending, batch.JobStatusRunnable, batch.JobStatusStarting, batch.JobStatusRunning},
+			Target:     []string{batch.JobStatusSucceeded, batch.JobStatusFailed},
+			Refresh:    batchJobRefreshStatusFunc(conn, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		outRaw, err := stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("error waiting for Batch Job (%s) to complete: %w", d.Id(), err)
+		}
+
+		if job := outRaw.(*batch.JobDetail); aws.StringValue(job.Status) == batch.JobStatusFailed {
+			return fmt.Errorf("Batch Job (%s) failed: %s", d.Id(), aws.StringValue(job.StatusReason))
+		}
+	}
+
+	return resourceAwsBatchJobRead(d, meta)
+}
+
+func resourceAwsBatchJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	job, err := getBatchJob(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		log.Printf("[WARN] Batch Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// job_queue and job_definition are set by the user as names (ForceNew);
+	// DescribeJobs returns them as ARNs, so they're deliberately not
+	// refreshed here to avoid a permanent name-vs-ARN diff.
+	d.Set("job_name", job.JobName)
+	d.Set("status", job.Status)
+	d.Set("status_reason", job.StatusReason)
+
+	if job.StartedAt != nil {
+		d.Set("started_at", time.Unix(0, aws.Int64Value(job.StartedAt)*int64(time.Millisecond)).UTC().String())
+	}
+	if job.StoppedAt != nil {
+		d.Set("stopped_at", time.Unix(0, aws.Int64Value(job.StoppedAt)*int64(time.Millisecond)).UTC().String())
+	}
+
+	if err := d.Set("container", flattenBatchJobContainer(job.Container)); err != nil {
+		return fmt.Errorf("error setting container: %w", err)
+	}
+
+	tags := keyvaluetags.BatchKeyValueTags(job.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBatchJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	job, err := getBatchJob(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if job == nil {
+		return nil
+	}
+
+	switch aws.StringValue(job.Status) {
+	case batch.JobStatusSucceeded, batch.JobStatusFailed:
+		return nil
+	case batch.JobStatusSubmitted, batch.JobStatusPending, batch.JobStatusRunnable:
+		log.Printf("[DEBUG] Cancelling Batch Job %s", d.Id())
+		_, err = conn.CancelJob(&batch.CancelJobInput{
+			JobId:  aws.String(d.Id()),
+			Reason: aws.String("terraform destroy"),
+		})
+	default:
+		log.Printf("[DEBUG] Terminating Batch Job %s", d.Id())
+		_, err = conn.TerminateJob(&batch.TerminateJobInput{
+			JobId:  aws.String(d.Id()),
+			Reason: aws.String("terraform destroy"),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("error stopping Batch Job (%s): %w", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JobStatusSubmitted, batch.JobStatusPending, batch.JobStatusRunnable, batch.JobStatusStarting, batch.JobStatusRunning},
+		Target:     []string{batch.JobStatusSucceeded, batch.JobStatusFailed},
+		Refresh:    batchJobRefreshStatusFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func getBatchJob(conn *batch.Batch, id string) (*batch.JobDetail, error) {
+	out, err := conn.DescribeJobs(&batch.DescribeJobsInput{
+		Jobs: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	numJobs := len(out.Jobs)
+	switch {
+	case numJobs == 0:
+		log.Printf("[DEBUG] Job %q is already gone", id)
+		return nil, nil
+	case numJobs == 1:
+		return out.Jobs[0], nil
+	default:
+		return nil, fmt.Errorf("Multiple Jobs with id %s", id)
+	}
+}
+
+func batchJobRefreshStatusFunc(conn *batch.Batch, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := getBatchJob(conn, id)
+		if err != nil {
+			return nil, "failed", err
+		}
+		if job == nil {
+			return nil, "failed", fmt.Errorf("Batch Job (%s) not found", id)
+		}
+		return job, aws.StringValue(job.Status), nil
+	}
+}
+
+func expandBatchJobParameters(m map[string]interface{}) map[string]*string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	params := make(map[string]*string, len(m))
+	for k, v := range m {
+		params[k] = aws.String(v.(string))
+	}
+	return params
+}
+
+func expandBatchJobContainerOverrides(l []interface{}) *batch.ContainerOverrides {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	overrides := &batch.ContainerOverrides{}
+
+	if v, ok := m["command"].([]interface{}); ok && len(v) > 0 {
+		overrides.Command = expandStringList(v)
+	}
+
+	if v, ok := m["vcpus"].(int); ok && v > 0 {
+		overrides.Vcpus = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["memory"].(int); ok && v > 0 {
+		overrides.Memory = aws.Int64(int64(v))
+	}
+
+	for _, rawEnv := range m["environment"].([]interface{}) {
+		e := rawEnv.(map[string]interface{})
+		overrides.Environment = append(overrides.Environment, &batch.KeyValuePair{
+			Name:  aws.String(e["name"].(string)),
+			Value: aws.String(e["value"].(string)),
+		})
+	}
+
+	for _, rawReq := range m["resource_requirements"].([]interface{}) {
+		r := rawReq.(map[string]interface{})
+		overrides.ResourceRequirements = append(overrides.ResourceRequirements, &batch.ResourceRequirement{
+			Type:  aws.String(r["type"].(string)),
+			Value: aws.String(r["value"].(string)),
+		})
+	}
+
+	return overrides
+}
+
+func expandBatchJobArrayProperties(l []interface{}) *batch.ArrayProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["size"].(int); ok && v > 0 {
+		return &batch.ArrayProperties{Size: aws.Int64(int64(v))}
+	}
+	return nil
+}
+
+func expandBatchJobDependsOn(l []interface{}) []*batch.JobDependency {
+	deps := make([]*batch.JobDependency, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		dep := &batch.JobDependency{
+			JobId: aws.String(m["job_id"].(string)),
+		}
+		if v, ok := m["type"].(string); ok && v != "" {
+			dep.Type = aws.String(v)
+		}
+		deps = append(deps, dep)
+	}
+	return deps
+}
+
+func expandBatchJobRetryStrategy(l []interface{}) *batch.RetryStrategy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["attempts"].(int); ok && v > 0 {
+		return &batch.RetryStrategy{Attempts: aws.Int64(int64(v))}
+	}
+	return nil
+}
+
+func expandBatchJobTimeout(l []interface{}) *batch.JobTimeout {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["attempt_duration_seconds"].(int); ok && v > 0 {
+		return &batch.JobTimeout{AttemptDurationSeconds: aws.Int64(int64(v))}
+	}
+	return nil
+}
+
+func flattenBatchJobContainer(c *batch.ContainerDetail) []interface{} {
+	if c == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"log_stream_name": aws.StringValue(c.LogStreamName),
+		"exit_code":       int(aws.Int64Value(c.ExitCode)),
+	}}
+}
@@ -14,6 +14,17 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+// batchJobQueueManagedByDefault is the managed_by value signifying that
+// Terraform itself owns priority/state/scheduling_policy_arn reconciliation
+// for the queue and waits for it to become VALID.
+const batchJobQueueManagedByDefault = "terraform-provider-aws"
+
+const (
+	batchJobQueueCreateTimeout = 10 * time.Minute
+	batchJobQueueUpdateTimeout = 10 * time.Minute
+	batchJobQueueDeleteTimeout = 10 * time.Minute
+)
+
 func resourceAwsBatchJobQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsBatchJobQueueCreate,
@@ -28,6 +39,12 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(batchJobQueueCreateTimeout),
+			Update: schema.DefaultTimeout(batchJobQueueUpdateTimeout),
+			Delete: schema.DefaultTimeout(batchJobQueueDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"compute_environment_order": {
 				Type:     schema.TypeList,
@@ -62,6 +79,16 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice([]string{batch.JQStateDisabled, batch.JQStateEnabled}, true),
 			},
+			"scheduling_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  batchJobQueueManagedByDefault,
+			},
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
 			"arn": {
@@ -85,6 +112,9 @@ func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) er
 		Priority:                aws.Int64(int64(d.Get("priority").(int))),
 		State:                   aws.String(d.Get("state").(string)),
 	}
+	if v, ok := d.GetOk("scheduling_policy_arn"); ok {
+		input.SchedulingPolicyArn = aws.String(v.(string))
+	}
 	if len(tags) > 0 {
 		input.Tags = tags.IgnoreAws().BatchTags()
 	}
@@ -95,18 +125,22 @@ func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("%s %q", err, name)
 	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{batch.JQStatusCreating, batch.JQStatusUpdating},
-		Target:     []string{batch.JQStatusValid},
-		Refresh:    batchJobQueueRefreshStatusFunc(conn, name),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+	if d.Get("managed_by").(string) == batchJobQueueManagedByDefault {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{batch.JQStatusCreating, batch.JQStatusUpdating},
+			Target:     []string{batch.JQStatusValid},
+			Refresh:    batchJobQueueRefreshStatusFunc(conn, name),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
 
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for JobQueue state to be \"VALID\": %s", err)
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for JobQueue state to be \"VALID\": %s", err)
+		}
+	} else {
+		log.Printf("[DEBUG] JobQueue %s is managed by %s, skipping wait for \"VALID\" state", name, d.Get("managed_by").(string))
 	}
 
 	arn := aws.StringValue(out.JobQueueArn)
@@ -150,6 +184,7 @@ func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("name", jq.JobQueueName)
 	d.Set("priority", jq.Priority)
 	d.Set("state", jq.State)
+	d.Set("scheduling_policy_arn", jq.SchedulingPolicyArn)
 
 	tags := keyvaluetags.BatchKeyValueTags(jq.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
 
@@ -168,31 +203,49 @@ func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) erro
 func resourceAwsBatchJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 
-	if d.HasChanges("compute_environments", "priority", "state") {
+	externallyManaged := d.Get("managed_by").(string) != batchJobQueueManagedByDefault
+
+	// Externally managed queues only have their compute_environment_order
+	// reconciled by Terraform; priority/state/scheduling_policy_arn are left
+	// to whatever system set managed_by.
+	reconcile := d.HasChange("compute_environment_order")
+	if !externallyManaged {
+		reconcile = reconcile || d.HasChanges("priority", "state", "scheduling_policy_arn")
+	}
+
+	if reconcile {
 		name := d.Get("name").(string)
 
 		updateInput := &batch.UpdateJobQueueInput{
 			ComputeEnvironmentOrder: createComputeEnvironmentOrder(d.Get("compute_environment_order").([]interface{})),
 			JobQueue:                aws.String(name),
-			Priority:                aws.Int64(int64(d.Get("priority").(int))),
-			State:                   aws.String(d.Get("state").(string)),
+		}
+		if !externallyManaged {
+			updateInput.Priority = aws.Int64(int64(d.Get("priority").(int)))
+			updateInput.State = aws.String(d.Get("state").(string))
+			if v, ok := d.GetOk("scheduling_policy_arn"); ok {
+				updateInput.SchedulingPolicyArn = aws.String(v.(string))
+			}
 		}
 		_, err := conn.UpdateJobQueue(updateInput)
 		if err != nil {
 			return err
 		}
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{batch.JQStatusUpdating},
-			Target:     []string{batch.JQStatusValid},
-			Refresh:    batchJobQueueRefreshStatusFunc(conn, name),
-			Timeout:    10 * time.Minute,
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return err
+		if !externallyManaged {
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{batch.JQStatusUpdating},
+				Target:     []string{batch.JQStatusValid},
+				Refresh:    batchJobQueueRefreshStatusFunc(conn, name),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      10 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			_, err = stateConf.WaitForState()
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -210,15 +263,16 @@ func resourceAwsBatchJobQueueUpdate(d *schema.ResourceData, meta interface{}) er
 func resourceAwsBatchJobQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 	name := d.Get("name").(string)
+	timeout := d.Timeout(schema.TimeoutDelete)
 
 	log.Printf("[DEBUG] Disabling Batch Job Queue %s", name)
-	err := disableBatchJobQueue(name, conn)
+	err := disableBatchJobQueue(name, conn, timeout)
 	if err != nil {
 		return fmt.Errorf("error disabling Batch Job Queue (%s): %s", name, err)
 	}
 
 	log.Printf("[DEBUG] Deleting Batch Job Queue %s", name)
-	err = deleteBatchJobQueue(name, conn)
+	err = deleteBatchJobQueue(name, conn, timeout)
 	if err != nil {
 		return fmt.Errorf("error deleting Batch Job Queue (%s): %s", name, err)
 	}
@@ -237,7 +291,7 @@ func createComputeEnvironmentOrder(order []interface{}) (envs []*batch.ComputeEn
 	return
 }
 
-func deleteBatchJobQueue(jobQueue string, conn *batch.Batch) error {
+func deleteBatchJobQueue(jobQueue string, conn *batch.Batch, timeout time.Duration) error {
 	_, err := conn.DeleteJobQueue(&batch.DeleteJobQueueInput{
 		JobQueue: aws.String(jobQueue),
 	})
@@ -249,7 +303,7 @@ func deleteBatchJobQueue(jobQueue string, conn *batch.Batch) error {
 		Pending:    []string{batch.JQStateDisabled, batch.JQStatusDeleting},
 		Target:     []string{batch.JQStatusDeleted},
 		Refresh:    batchJobQueueRefreshStatusFunc(conn, jobQueue),
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -258,7 +312,7 @@ func deleteBatchJobQueue(jobQueue string, conn *batch.Batch) error {
 	return err
 }
 
-func disableBatchJobQueue(jobQueue string, conn *batch.Batch) error {
+func disableBatchJobQueue(jobQueue string, conn *batch.Batch, timeout time.Duration) error {
 	_, err := conn.UpdateJobQueue(&batch.UpdateJobQueueInput{
 		JobQueue: aws.String(jobQueue),
 		State:    aws.String(batch.JQStateDisabled),
@@ -271,7 +325,7 @@ func disableBatchJobQueue(jobQueue string, conn *batch.Batch) error {
 		Pending:    []string{batch.JQStatusUpdating},
 		Target:     []string{batch.JQStatusValid},
 		Refresh:    batchJobQueueRefreshStatusFunc(conn, jobQueue),
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
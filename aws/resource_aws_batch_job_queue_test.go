@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+		Name: "aws_batch_job_queue",
+		F:    testSweepBatchJobQueues,
+	})
+}
+
+func testSweepBatchJobQueues(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).batchconn
+
+	out, err := conn.DescribeJobQueues(&batch.DescribeJobQueuesInput{})
+	if err != nil {
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping Batch Job Queue sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("error listing Batch Job Queues: %w", err)
+	}
+
+	for _, jq := range out.JobQueues {
+		name := aws.StringValue(jq.JobQueueName)
+
+		log.Printf("[INFO] Disabling Batch Job Queue: %s", name)
+		if err := disableBatchJobQueue(name, conn, batchJobQueueDeleteTimeout); err != nil {
+			log.Printf("[ERROR] Failed to disable Batch Job Queue (%s): %s", name, err)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting Batch Job Queue: %s", name)
+		if err := deleteBatchJobQueue(name, conn, batchJobQueueDeleteTimeout); err != nil {
+			log.Printf("[ERROR] Failed to delete Batch Job Queue (%s): %s", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsBatchSchedulingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBatchSchedulingPolicyCreate,
+		Read:   resourceAwsBatchSchedulingPolicyRead,
+		Update: resourceAwsBatchSchedulingPolicyUpdate,
+		Delete: resourceAwsBatchSchedulingPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("arn", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchName,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fair_share_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_reservation": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 99),
+						},
+						"share_decay_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 604800),
+						},
+						"share_distribution": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 500,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"share_identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"weight_factor": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatBetween(0, 999.9999),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+}
+
+func resourceAwsBatchSchedulingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &batch.CreateSchedulingPolicyInput{
+		Name:            aws.String(name),
+		FairsharePolicy: expandBatchFairsharePolicy(d.Get("fair_share_policy").([]interface{})),
+	}
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().BatchTags()
+	}
+
+	out, err := conn.CreateSchedulingPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating Batch Scheduling Policy (%s): %w", name, err)
+	}
+
+	arn := aws.StringValue(out.Arn)
+	log.Printf("[DEBUG] Scheduling Policy created: %s", arn)
+	d.SetId(arn)
+
+	return resourceAwsBatchSchedulingPolicyRead(d, meta)
+}
+
+func resourceAwsBatchSchedulingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	sp, err := getSchedulingPolicy(conn, d.Id())
+	if err != nil {
+		return err
+	}
+	if sp == nil {
+		log.Printf("[WARN] Batch Scheduling Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", sp.Arn)
+	d.Set("name", sp.Name)
+
+	if err := d.Set("fair_share_policy", flattenBatchFairsharePolicy(sp.FairsharePolicy)); err != nil {
+		return fmt.Errorf("error setting fair_share_policy: %w", err)
+	}
+
+	tags := keyvaluetags.BatchKeyValueTags(sp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsBatchSchedulingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	if d.HasChange("fair_share_policy") {
+		_, err := conn.UpdateSchedulingPolicy(&batch.UpdateSchedulingPolicyInput{
+			Arn:             aws.String(d.Id()),
+			FairsharePolicy: expandBatchFairsharePolicy(d.Get("fair_share_policy").([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Batch Scheduling Policy (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.BatchUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %s", err)
+		}
+	}
+
+	return resourceAwsBatchSchedulingPolicyRead(d, meta)
+}
+
+func resourceAwsBatchSchedulingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).batchconn
+
+	log.Printf("[DEBUG] Deleting Batch Scheduling Policy %s", d.Id())
+	_, err := conn.DeleteSchedulingPolicy(&batch.DeleteSchedulingPolicyInput{
+		Arn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Batch Scheduling Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getSchedulingPolicy(conn *batch.Batch, arn string) (*batch.SchedulingPolicyDetail, error) {
+	out, err := conn.DescribeSchedulingPolicies(&batch.DescribeSchedulingPoliciesInput{
+		Arns: []*string{aws.String(arn)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	numPolicies := len(out.SchedulingPolicies)
+	switch {
+	case numPolicies == 0:
+		log.Printf("[DEBUG] Scheduling Policy %q is already gone", arn)
+		return nil, nil
+	case numPolicies == 1:
+		return out.SchedulingPolicies[0], nil
+	default:
+		return nil, fmt.Errorf("Multiple Scheduling Policies with ARN %s", arn)
+	}
+}
+
+func expandBatchFairsharePolicy(l []interface{}) *batch.FairsharePolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	fsp := &batch.FairsharePolicy{}
+
+	if v, ok := m["compute_reservation"].(int); ok && v != 0 {
+		fsp.ComputeReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["share_decay_seconds"].(int); ok && v != 0 {
+		fsp.ShareDecaySeconds = aws.Int64(int64(v))
+	}
+
+	for _, rawDistribution := range m["share_distribution"].(*schema.Set).List() {
+		d := rawDistribution.(map[string]interface{})
+		fsp.ShareDistribution = append(fsp.ShareDistribution, &batch.ShareAttributes{
+			ShareIdentifier: aws.String(d["share_identifier"].(string)),
+			WeightFactor:    aws.Float64(d["weight_factor"].(float64)),
+		})
+	}
+
+	return fsp
+}
+
+func flattenBatchFairsharePolicy(fsp *batch.FairsharePolicy) []interface{} {
+	if fsp == nil {
+		return nil
+	}
+
+	shareDistribution := make([]interface{}, 0, len(fsp.ShareDistribution))
+	for _, d := range fsp.ShareDistribution {
+		shareDistribution = append(shareDistribution, map[string]interface{}{
+			"share_identifier": aws.StringValue(d.ShareIdentifier),
+			"weight_factor":    aws.Float64Value(d.WeightFactor),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"compute_reservation": int(aws.Int64Value(fsp.ComputeReservation)),
+		"share_decay_seconds": int(aws.Int64Value(fsp.ShareDecaySeconds)),
+		"share_distribution":  shareDistribution,
+	}}
+}